@@ -0,0 +1,15 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+// newReleaseCmd builds the `release` command tree, wiring the read-only
+// `list`/`show` subcommands onto it so they're reachable from the CLI
+// instead of only existing as unregistered constructors.
+func newReleaseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release",
+		Short: "Releases the current project",
+	}
+	addReleaseInspectCommands(cmd)
+	return cmd
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/goreleaser/goreleaser/internal/client"
+	"github.com/goreleaser/goreleaser/pkg/config"
+	"github.com/goreleaser/goreleaser/pkg/context"
+	"github.com/spf13/cobra"
+)
+
+// addReleaseInspectCommands wires the read-only `release list`/`release
+// show` subcommands, and the --config flag they share, onto the given
+// `release` command (see newReleaseCmd).
+func addReleaseInspectCommands(releaseCmd *cobra.Command) {
+	releaseCmd.AddCommand(newReleaseListCmd().cmd)
+	releaseCmd.AddCommand(newReleaseShowCmd().cmd)
+	releaseCmd.PersistentFlags().String("config", "", "load configuration from file")
+}
+
+// releaseClientFor resolves the forge client (GitHub, GitLab or Gitea,
+// whichever is configured) and the repo to query for the `release
+// list`/`release show` commands.
+func releaseClientFor(cmd *cobra.Command) (*context.Context, client.Repo, client.Client, error) {
+	path, err := cmd.Flags().GetString("config")
+	if err != nil {
+		return nil, client.Repo{}, nil, err
+	}
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		return nil, client.Repo{}, nil, fmt.Errorf("loading config: %w", err)
+	}
+
+	ctx := context.New(cfg)
+
+	switch {
+	case cfg.Release.GitHub.Name != "":
+		token := os.Getenv("GITHUB_TOKEN")
+		cli, err := client.NewGitHub(ctx, token)
+		if err != nil {
+			return nil, client.Repo{}, nil, err
+		}
+		return ctx, client.Repo{Owner: cfg.Release.GitHub.Owner, Name: cfg.Release.GitHub.Name}, cli, nil
+	case cfg.Release.GitLab.Name != "":
+		token := os.Getenv("GITLAB_TOKEN")
+		cli, err := client.NewGitLab(ctx, token)
+		if err != nil {
+			return nil, client.Repo{}, nil, err
+		}
+		return ctx, client.Repo{Owner: cfg.Release.GitLab.Owner, Name: cfg.Release.GitLab.Name}, cli, nil
+	case cfg.Release.Gitea.Name != "":
+		token := os.Getenv("GITEA_TOKEN")
+		cli, err := client.NewGitea(ctx, token)
+		if err != nil {
+			return nil, client.Repo{}, nil, err
+		}
+		return ctx, client.Repo{Owner: cfg.Release.Gitea.Owner, Name: cfg.Release.Gitea.Name}, cli, nil
+	default:
+		return nil, client.Repo{}, nil, fmt.Errorf("no github, gitlab or gitea release target configured")
+	}
+}
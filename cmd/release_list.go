@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/goreleaser/goreleaser/internal/client"
+	"github.com/spf13/cobra"
+)
+
+type releaseListCmd struct {
+	cmd    *cobra.Command
+	drafts bool
+	limit  int
+	json   bool
+}
+
+func newReleaseListCmd() *releaseListCmd {
+	root := &releaseListCmd{}
+	cmd := &cobra.Command{
+		Use:           "list",
+		Short:         "List releases for the configured repository",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return root.list(cmd)
+		},
+	}
+
+	cmd.Flags().BoolVar(&root.drafts, "drafts", false, "include draft releases")
+	cmd.Flags().IntVar(&root.limit, "limit", 0, "limit the number of releases returned (0 means no limit)")
+	cmd.Flags().BoolVar(&root.json, "json", false, "output as JSON")
+
+	root.cmd = cmd
+	return root
+}
+
+func (r *releaseListCmd) list(cmd *cobra.Command) error {
+	ctx, repo, cli, err := releaseClientFor(cmd)
+	if err != nil {
+		return err
+	}
+
+	releases, err := cli.ListReleases(ctx, repo, client.ListReleasesOptions{
+		IncludeDrafts: r.drafts,
+		Limit:         r.limit,
+	})
+	if err != nil {
+		return fmt.Errorf("listing releases: %w", err)
+	}
+
+	if r.json {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(releases)
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "TAG\tNAME\tDRAFT\tPRERELEASE\tPUBLISHED\tASSETS\tSIZE")
+	for _, rel := range releases {
+		fmt.Fprintf(
+			w,
+			"%s\t%s\t%t\t%t\t%s\t%d\t%d\n",
+			rel.Tag,
+			rel.Name,
+			rel.Draft,
+			rel.Prerelease,
+			rel.PublishedAt.Format("2006-01-02"),
+			len(rel.Assets),
+			rel.TotalAssetSize(),
+		)
+	}
+	return w.Flush()
+}
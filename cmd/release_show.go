@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+type releaseShowCmd struct {
+	cmd  *cobra.Command
+	json bool
+}
+
+func newReleaseShowCmd() *releaseShowCmd {
+	root := &releaseShowCmd{}
+	cmd := &cobra.Command{
+		Use:           "show <tag>",
+		Short:         "Show a single release for the configured repository",
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return root.show(cmd, args[0])
+		},
+	}
+
+	cmd.Flags().BoolVar(&root.json, "json", false, "output as JSON")
+
+	root.cmd = cmd
+	return root
+}
+
+func (r *releaseShowCmd) show(cmd *cobra.Command, tag string) error {
+	ctx, repo, cli, err := releaseClientFor(cmd)
+	if err != nil {
+		return err
+	}
+
+	release, err := cli.GetReleaseByTag(ctx, repo, tag)
+	if err != nil {
+		return fmt.Errorf("getting release %q: %w", tag, err)
+	}
+
+	if r.json {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(release)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Tag:        %s\n", release.Tag)
+	fmt.Fprintf(cmd.OutOrStdout(), "Name:       %s\n", release.Name)
+	fmt.Fprintf(cmd.OutOrStdout(), "Draft:      %t\n", release.Draft)
+	fmt.Fprintf(cmd.OutOrStdout(), "Prerelease: %t\n", release.Prerelease)
+	fmt.Fprintf(cmd.OutOrStdout(), "Published:  %s\n", release.PublishedAt.Format("2006-01-02 15:04:05"))
+	fmt.Fprintf(cmd.OutOrStdout(), "Assets:     %d (%d bytes total)\n", len(release.Assets), release.TotalAssetSize())
+	for _, a := range release.Assets {
+		fmt.Fprintf(cmd.OutOrStdout(), "  - %s (%d bytes)\n", a.Name, a.Size)
+	}
+	return nil
+}
@@ -0,0 +1,351 @@
+package client
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/caarlos0/log"
+	"github.com/goreleaser/goreleaser/internal/artifact"
+	"github.com/goreleaser/goreleaser/internal/tmpl"
+	"github.com/goreleaser/goreleaser/pkg/config"
+	"github.com/goreleaser/goreleaser/pkg/context"
+)
+
+type giteaClient struct {
+	client *gitea.Client
+}
+
+// NewGitea returns a gitea client implementation.
+func NewGitea(ctx *context.Context, token string) (Client, error) {
+	apiURL, err := tmpl.New(ctx).Apply(ctx.Config.GiteaURLs.API)
+	if err != nil {
+		return &giteaClient{}, fmt.Errorf("templating Gitea API URL: %w", err)
+	}
+	if apiURL == "" {
+		return &giteaClient{}, fmt.Errorf("gitea_urls.api is required")
+	}
+
+	httpClient := &http.Client{
+		// nolint: gosec
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: ctx.Config.GiteaURLs.SkipTLSVerify,
+			},
+			Proxy: http.ProxyFromEnvironment,
+		},
+	}
+
+	client, err := gitea.NewClient(
+		apiURL,
+		gitea.SetToken(token),
+		gitea.SetHTTPClient(httpClient),
+	)
+	if err != nil {
+		return &giteaClient{}, err
+	}
+
+	if err := checkGiteaTokenScope(client); err != nil {
+		return &giteaClient{}, err
+	}
+
+	return &giteaClient{client: client}, nil
+}
+
+// checkGiteaTokenScope verifies the token can actually authenticate and,
+// when the response reports the token's scopes, that they include the
+// "write:repository" scope goreleaser needs to create releases, upload
+// assets and manage milestones — mirroring the scope check done for PATs
+// on the GitHub side.
+func checkGiteaTokenScope(client *gitea.Client) error {
+	_, resp, err := client.GetMyUserInfo()
+	if err != nil {
+		return fmt.Errorf("checking Gitea token: %w", err)
+	}
+
+	scopes := resp.Header.Get("X-OAuth-Scopes")
+	if scopes == "" {
+		// classic access tokens (as opposed to OAuth2 app tokens) don't
+		// carry a scopes header; nothing more we can check.
+		return nil
+	}
+	if !strings.Contains(scopes, "write:repository") && !strings.Contains(scopes, "all") {
+		return fmt.Errorf("token is missing the write:repository scope, got: %s", scopes)
+	}
+	return nil
+}
+
+func (c *giteaClient) GenerateReleaseNotes(ctx *context.Context, repo Repo, prev, current string) (string, error) {
+	return "", nil
+}
+
+// ListReleases returns the releases of the configured repo, newest first,
+// optionally including drafts and capped at opts.Limit (0 means no cap).
+func (c *giteaClient) ListReleases(ctx *context.Context, repo Repo, opts ListReleasesOptions) ([]Release, error) {
+	var releases []Release
+	page := 1
+	for {
+		listOpts := gitea.ListReleasesOptions{
+			ListOptions: gitea.ListOptions{Page: page, PageSize: 50},
+		}
+		// Gitea's draft filter returns only drafts when true, so it's only
+		// set to exclude them; including drafts means not filtering at all.
+		if !opts.IncludeDrafts {
+			listOpts.IsDraft = gitea.OptionalBool(false)
+		}
+		page2, resp, err := c.client.ListReleases(repo.Owner, repo.Name, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page2 {
+			if r.IsDraft && !opts.IncludeDrafts {
+				continue
+			}
+			releases = append(releases, giteaToRelease(r))
+			if opts.Limit > 0 && len(releases) >= opts.Limit {
+				return releases, nil
+			}
+		}
+		if resp == nil || page >= resp.LastPage {
+			break
+		}
+		page++
+	}
+	return releases, nil
+}
+
+// GetReleaseByTag returns a single release identified by its tag.
+func (c *giteaClient) GetReleaseByTag(ctx *context.Context, repo Repo, tag string) (*Release, error) {
+	r, _, err := c.client.GetReleaseByTag(repo.Owner, repo.Name, tag)
+	if err != nil {
+		return nil, err
+	}
+	release := giteaToRelease(r)
+	return &release, nil
+}
+
+func giteaToRelease(r *gitea.Release) Release {
+	assets := make([]ReleaseAsset, 0, len(r.Attachments))
+	for _, a := range r.Attachments {
+		assets = append(assets, ReleaseAsset{Name: a.Name, Size: int64(a.Size)})
+	}
+	return Release{
+		ID:          r.ID,
+		Tag:         r.TagName,
+		Name:        r.Title,
+		Draft:       r.IsDraft,
+		Prerelease:  r.IsPrerelease,
+		PublishedAt: r.PublishedAt,
+		Assets:      assets,
+	}
+}
+
+func (c *giteaClient) Changelog(ctx *context.Context, repo Repo, prev, current string) (string, error) {
+	var entries []string
+
+	page := 1
+	for {
+		commits, resp, err := c.client.CompareCommits(repo.Owner, repo.Name, prev, current, gitea.ListCommitOptions{
+			ListOptions: gitea.ListOptions{Page: page, PageSize: 50},
+		})
+		if err != nil {
+			return "", err
+		}
+		for _, commit := range commits.Commits {
+			author := ""
+			if commit.Author != nil {
+				author = commit.Author.UserName
+			}
+			entries = append(entries, fmt.Sprintf(
+				"%s: %s (@%s)",
+				commit.SHA,
+				strings.Split(commit.RepoCommit.Message, "\n")[0],
+				author,
+			))
+		}
+		if resp == nil || page >= resp.LastPage {
+			break
+		}
+		page++
+	}
+
+	return strings.Join(entries, "\n"), nil
+}
+
+func (c *giteaClient) GetDefaultBranch(ctx *context.Context, repo Repo) (string, error) {
+	r, _, err := c.client.GetRepo(repo.Owner, repo.Name)
+	if err != nil {
+		return "", err
+	}
+	return r.DefaultBranch, nil
+}
+
+// CloseMilestone closes a given milestone.
+func (c *giteaClient) CloseMilestone(ctx *context.Context, repo Repo, title string) error {
+	milestone, err := c.getMilestoneByTitle(repo, title)
+	if err != nil {
+		return err
+	}
+	if milestone == nil {
+		return ErrNoMilestoneFound{Title: title}
+	}
+
+	closedState := gitea.StateClosed
+	_, _, err = c.client.EditMilestone(repo.Owner, repo.Name, milestone.ID, gitea.EditMilestoneOption{
+		Title: milestone.Title,
+		State: &closedState,
+	})
+	return err
+}
+
+func (c *giteaClient) getMilestoneByTitle(repo Repo, title string) (*gitea.Milestone, error) {
+	page := 1
+	for {
+		milestones, resp, err := c.client.ListRepoMilestones(repo.Owner, repo.Name, gitea.ListMilestoneOption{
+			ListOptions: gitea.ListOptions{Page: page, PageSize: 50},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range milestones {
+			if m.Title == title {
+				return m, nil
+			}
+		}
+		if resp == nil || page >= resp.LastPage {
+			break
+		}
+		page++
+	}
+	return nil, nil
+}
+
+func (c *giteaClient) CreateFile(
+	ctx *context.Context,
+	commitAuthor config.CommitAuthor,
+	repo Repo,
+	content []byte,
+	path,
+	message string,
+) error {
+	branch := repo.Branch
+	if branch == "" {
+		b, err := c.GetDefaultBranch(ctx, repo)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"fileName":  path,
+				"projectID": repo.String(),
+				"err":       err.Error(),
+			}).Warn("error checking for default branch, using default")
+		}
+		branch = b
+	}
+
+	opts := gitea.CreateFileOptions{
+		FileOptions: gitea.FileOptions{
+			Message:    message,
+			BranchName: branch,
+			Author: gitea.Identity{
+				Name:  commitAuthor.Name,
+				Email: commitAuthor.Email,
+			},
+		},
+		Content: base64.StdEncoding.EncodeToString(content),
+	}
+
+	if _, _, err := c.client.GetContents(repo.Owner, repo.Name, branch, path); err != nil {
+		_, _, err := c.client.CreateFile(repo.Owner, repo.Name, path, opts)
+		return err
+	}
+
+	updateOpts := gitea.UpdateFileOptions{
+		FileOptions: opts.FileOptions,
+		Content:     opts.Content,
+	}
+	_, _, err := c.client.UpdateFile(repo.Owner, repo.Name, path, updateOpts)
+	return err
+}
+
+func (c *giteaClient) CreateRelease(ctx *context.Context, body string) (string, error) {
+	title, err := tmpl.New(ctx).Apply(ctx.Config.Release.NameTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	owner := ctx.Config.Release.Gitea.Owner
+	name := ctx.Config.Release.Gitea.Name
+
+	release, _, err := c.client.GetReleaseByTag(owner, name, ctx.Git.CurrentTag)
+	if err != nil {
+		release, _, err := c.client.CreateRelease(owner, name, gitea.CreateReleaseOption{
+			TagName:      ctx.Git.CurrentTag,
+			Title:        title,
+			Note:         body,
+			IsDraft:      ctx.Config.Release.Draft,
+			IsPrerelease: ctx.PreRelease,
+		})
+		if err != nil {
+			return "", fmt.Errorf("could not release: %w", err)
+		}
+		return strconv.FormatInt(release.ID, 10), nil
+	}
+
+	release, _, err = c.client.EditRelease(owner, name, release.ID, gitea.EditReleaseOption{
+		TagName:      release.TagName,
+		Title:        title,
+		Note:         body,
+		IsDraft:      &release.IsDraft,
+		IsPrerelease: &release.IsPrerelease,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not release: %w", err)
+	}
+	return strconv.FormatInt(release.ID, 10), nil
+}
+
+func (c *giteaClient) ReleaseURLTemplate(ctx *context.Context) (string, error) {
+	downloadURL, err := tmpl.New(ctx).Apply(ctx.Config.GiteaURLs.Download)
+	if err != nil {
+		return "", fmt.Errorf("templating Gitea download URL: %w", err)
+	}
+
+	return fmt.Sprintf(
+		"%s/%s/%s/releases/download/{{ .Tag }}/{{ .ArtifactName }}",
+		downloadURL,
+		ctx.Config.Release.Gitea.Owner,
+		ctx.Config.Release.Gitea.Name,
+	), nil
+}
+
+func (c *giteaClient) Upload(
+	ctx *context.Context,
+	releaseID string,
+	artifact *artifact.Artifact,
+	file *os.File,
+) error {
+	id, err := strconv.ParseInt(releaseID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = c.client.CreateReleaseAttachment(
+		ctx.Config.Release.Gitea.Owner,
+		ctx.Config.Release.Gitea.Name,
+		id,
+		file,
+		artifact.Name,
+	)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"name":       artifact.Name,
+			"release-id": releaseID,
+		}).Warn("upload failed")
+		return RetriableError{err}
+	}
+	return nil
+}
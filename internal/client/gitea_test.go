@@ -0,0 +1,67 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goreleaser/goreleaser/pkg/config"
+	"github.com/goreleaser/goreleaser/pkg/context"
+)
+
+func TestGiteaCreateRelease(t *testing.T) {
+	var created bool
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-OAuth-Scopes", "write:repository")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/version":
+			// the SDK checks the server version when the client is created.
+			json.NewEncoder(w).Encode(map[string]any{"version": "1.17.0"}) //nolint:errcheck
+
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/user":
+			json.NewEncoder(w).Encode(map[string]any{"login": "goreleaser"}) //nolint:errcheck
+
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/repos/acme/widgets/releases/tags/v1.0.0":
+			w.WriteHeader(http.StatusNotFound)
+
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/repos/acme/widgets/releases":
+			created = true
+			json.NewEncoder(w).Encode(map[string]any{ //nolint:errcheck
+				"id":       1,
+				"tag_name": "v1.0.0",
+				"name":     "v1.0.0",
+			})
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.New(config.Project{
+		Release: config.Release{
+			Gitea: config.Gitea{Owner: "acme", Name: "widgets"},
+		},
+		GiteaURLs: config.GiteaURLs{API: srv.URL},
+	})
+	ctx.Git.CurrentTag = "v1.0.0"
+
+	cli, err := NewGitea(ctx, "some-token")
+	if err != nil {
+		t.Fatalf("NewGitea() error = %v", err)
+	}
+
+	id, err := cli.CreateRelease(ctx, "release notes")
+	if err != nil {
+		t.Fatalf("CreateRelease() error = %v", err)
+	}
+	if id != "1" {
+		t.Errorf("CreateRelease() id = %q, want %q", id, "1")
+	}
+	if !created {
+		t.Error("expected the release creation endpoint to be hit")
+	}
+}
@@ -3,12 +3,14 @@ package client
 import (
 	"crypto/tls"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/caarlos0/log"
 	"github.com/google/go-github/v48/github"
@@ -22,11 +24,22 @@ import (
 const DefaultGitHubDownloadURL = "https://github.com"
 
 type githubClient struct {
-	client *github.Client
+	client    *github.Client
+	transport *retryTransport
 }
 
-// NewGitHub returns a github client implementation.
+// NewGitHub returns a github client implementation. It transparently
+// switches to GitHub App installation auth when github_urls.app is set and
+// the GITHUB_APP_* env vars are present, so every caller — including the
+// release pipeline — benefits without having to know which auth mode is
+// in use.
 func NewGitHub(ctx *context.Context, token string) (GitHubClient, error) {
+	if appClient, ok, err := githubAppFromEnv(ctx); err != nil {
+		return &githubClient{}, err
+	} else if ok {
+		return appClient, nil
+	}
+
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: token},
 	)
@@ -41,7 +54,9 @@ func NewGitHub(ctx *context.Context, token string) (GitHubClient, error) {
 		InsecureSkipVerify: ctx.Config.GitHubURLs.SkipTLSVerify,
 	}
 	base.(*http.Transport).Proxy = http.ProxyFromEnvironment
-	httpClient.Transport.(*oauth2.Transport).Base = base
+
+	transport := newRetryTransport(base, ctx.Config.GitHubURLs.Retries)
+	httpClient.Transport.(*oauth2.Transport).Base = transport
 
 	client := github.NewClient(httpClient)
 	err := overrideGitHubClientAPI(ctx, client)
@@ -49,7 +64,16 @@ func NewGitHub(ctx *context.Context, token string) (GitHubClient, error) {
 		return &githubClient{}, err
 	}
 
-	return &githubClient{client: client}, nil
+	return &githubClient{client: client, transport: transport}, nil
+}
+
+// RateRemaining returns the last observed GitHub rate-limit budget, or -1
+// if no request has completed yet.
+func (c *githubClient) RateRemaining() int64 {
+	if c.transport == nil {
+		return -1
+	}
+	return c.transport.RateRemaining()
 }
 
 func (c *githubClient) GenerateReleaseNotes(ctx *context.Context, repo Repo, prev, current string) (string, error) {
@@ -314,7 +338,29 @@ func (c *githubClient) Upload(
 	if err != nil {
 		return err
 	}
-	_, resp, err := c.client.Repositories.UploadReleaseAsset(
+
+	// back off proactively when we're close to the rate-limit budget
+	// instead of waiting for the retrying transport to do it for us.
+	if remaining := c.RateRemaining(); remaining >= 0 && remaining < minRateRemaining {
+		log.WithFields(log.Fields{
+			"name":      artifact.Name,
+			"remaining": remaining,
+		}).Warn("rate-limit budget nearly exhausted, slowing down uploads")
+		time.Sleep(time.Second)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	// GitHub's release-asset upload endpoint has no resumable/chunked-append
+	// support: every POST to it creates a brand-new, complete asset, so
+	// there's no way to stream a large file in parts. Instead we upload it
+	// whole in one request and rely on the retrying transport (which
+	// rewinds the file before each attempt) to recover from a dropped
+	// connection or a transient 5xx mid-transfer.
+	asset, resp, err := c.client.Repositories.UploadReleaseAsset(
 		ctx,
 		ctx.Config.Release.GitHub.Owner,
 		ctx.Config.Release.GitHub.Name,
@@ -334,14 +380,86 @@ func (c *githubClient) Upload(
 			"release-id": releaseID,
 			"request-id": requestID,
 		}).Warn("upload failed")
+		// retries, backoff and rate-limit handling already happened inside
+		// the transport, so whatever bubbles up here is terminal.
+		return err
 	}
-	if err == nil {
-		return nil
-	}
-	if resp != nil && resp.StatusCode == 422 {
+
+	if !assetMatches(asset, info.Size()) {
+		log.WithFields(log.Fields{"name": artifact.Name}).Warn("uploaded asset metadata mismatch, retrying once")
+		if _, err := c.client.Repositories.DeleteReleaseAsset(ctx, ctx.Config.Release.GitHub.Owner, ctx.Config.Release.GitHub.Name, asset.GetID()); err != nil {
+			return fmt.Errorf("deleting mismatched asset: %w", err)
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		_, _, err = c.client.Repositories.UploadReleaseAsset(
+			ctx,
+			ctx.Config.Release.GitHub.Owner,
+			ctx.Config.Release.GitHub.Name,
+			githubReleaseID,
+			&github.UploadOptions{Name: artifact.Name},
+			file,
+		)
 		return err
 	}
-	return RetriableError{err}
+
+	return nil
+}
+
+// ListReleases returns the releases of the configured repo, newest first,
+// optionally including drafts and capped at opts.Limit (0 means no cap).
+func (c *githubClient) ListReleases(ctx *context.Context, repo Repo, opts ListReleasesOptions) ([]Release, error) {
+	listOpts := &github.ListOptions{PerPage: 100}
+
+	var releases []Release
+	for {
+		page, resp, err := c.client.Repositories.ListReleases(ctx, repo.Owner, repo.Name, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page {
+			if r.GetDraft() && !opts.IncludeDrafts {
+				continue
+			}
+			releases = append(releases, toRelease(r))
+			if opts.Limit > 0 && len(releases) >= opts.Limit {
+				return releases, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpts.Page = resp.NextPage
+	}
+
+	return releases, nil
+}
+
+// GetReleaseByTag returns a single release identified by its tag.
+func (c *githubClient) GetReleaseByTag(ctx *context.Context, repo Repo, tag string) (*Release, error) {
+	r, _, err := c.client.Repositories.GetReleaseByTag(ctx, repo.Owner, repo.Name, tag)
+	if err != nil {
+		return nil, err
+	}
+	release := toRelease(r)
+	return &release, nil
+}
+
+func toRelease(r *github.RepositoryRelease) Release {
+	assets := make([]ReleaseAsset, 0, len(r.Assets))
+	for _, a := range r.Assets {
+		assets = append(assets, ReleaseAsset{Name: a.GetName(), Size: int64(a.GetSize())})
+	}
+	return Release{
+		ID:          r.GetID(),
+		Tag:         r.GetTagName(),
+		Name:        r.GetName(),
+		Draft:       r.GetDraft(),
+		Prerelease:  r.GetPrerelease(),
+		PublishedAt: r.GetPublishedAt().Time,
+		Assets:      assets,
+	}
 }
 
 // getMilestoneByTitle returns a milestone by title.
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/caarlos0/env/v6"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/go-github/v48/github"
+	"github.com/goreleaser/goreleaser/pkg/context"
+)
+
+// GitHubAppConfig holds the env vars used to authenticate as a GitHub App
+// installation, as an alternative to a long-lived personal access token.
+type GitHubAppConfig struct {
+	ID             string `env:"GITHUB_APP_ID"`
+	InstallationID string `env:"GITHUB_APP_INSTALLATION_ID"`
+	PrivateKey     string `env:"GITHUB_APP_PRIVATE_KEY"`
+	PrivateKeyFile string `env:"GITHUB_APP_PRIVATE_KEY_FILE"`
+}
+
+func (c GitHubAppConfig) enabled() bool {
+	return c.ID != "" && c.InstallationID != "" && (c.PrivateKey != "" || c.PrivateKeyFile != "")
+}
+
+func (c GitHubAppConfig) key() ([]byte, error) {
+	if c.PrivateKey != "" {
+		return []byte(c.PrivateKey), nil
+	}
+	return os.ReadFile(c.PrivateKeyFile)
+}
+
+// githubAppFromEnv returns a GitHub App client when github_urls.app is set
+// and the GITHUB_APP_* env vars are present, and ok=false otherwise so the
+// caller falls back to a regular token-based client. NewGitHub checks this
+// itself, so every existing call site — including the release pipeline —
+// picks up App auth for free once it's configured.
+func githubAppFromEnv(ctx *context.Context) (GitHubClient, bool, error) {
+	if !ctx.Config.GitHubURLs.App {
+		return nil, false, nil
+	}
+
+	var cfg GitHubAppConfig
+	if err := env.Parse(&cfg); err != nil {
+		return nil, false, fmt.Errorf("parsing GitHub App config: %w", err)
+	}
+	if !cfg.enabled() {
+		return nil, false, nil
+	}
+
+	key, err := cfg.key()
+	if err != nil {
+		return nil, false, fmt.Errorf("reading GitHub App private key: %w", err)
+	}
+
+	client, err := NewGitHubApp(ctx, cfg.ID, cfg.InstallationID, key)
+	return client, true, err
+}
+
+const (
+	jwtValidity          = 10 * time.Minute
+	installationTokenTTL = 1 * time.Hour
+	tokenRefreshSkew     = 1 * time.Minute
+)
+
+// NewGitHubApp returns a github client implementation that authenticates as
+// a GitHub App installation instead of a personal access token.
+func NewGitHubApp(ctx *context.Context, appID, installationID string, privateKeyPEM []byte) (GitHubClient, error) {
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(privateKeyPEM)
+	if err != nil {
+		return &githubClient{}, fmt.Errorf("parsing GitHub App private key: %w", err)
+	}
+
+	base := &http.Transport{
+		// nolint: gosec
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: ctx.Config.GitHubURLs.SkipTLSVerify,
+		},
+		Proxy: http.ProxyFromEnvironment,
+	}
+
+	transport := newRetryTransport(base, ctx.Config.GitHubURLs.Retries)
+
+	appTransport := &appInstallationTransport{
+		appID:          appID,
+		installationID: installationID,
+		key:            key,
+		base:           transport,
+	}
+
+	client := github.NewClient(&http.Client{Transport: appTransport})
+	if err := overrideGitHubClientAPI(ctx, client); err != nil {
+		return &githubClient{}, err
+	}
+	appTransport.apiURL = client.BaseURL.String()
+
+	return &githubClient{client: client, transport: transport}, nil
+}
+
+// appInstallationTransport mints and caches a GitHub App installation
+// access token, refreshing it shortly before it expires.
+type appInstallationTransport struct {
+	appID          string
+	installationID string
+	key            *rsa.PrivateKey
+	base           http.RoundTripper
+	apiURL         string
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func (t *appInstallationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.installationToken(req)
+	if err != nil {
+		return nil, fmt.Errorf("minting GitHub App installation token: %w", err)
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "token "+token)
+	return t.base.RoundTrip(req)
+}
+
+func (t *appInstallationTransport) installationToken(req *http.Request) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != "" && time.Now().Before(t.expiresAt.Add(-tokenRefreshSkew)) {
+		return t.token, nil
+	}
+
+	jwtToken, err := t.signAppJWT()
+	if err != nil {
+		return "", err
+	}
+
+	apiURL := strings.TrimSuffix(t.apiURL, "/")
+	if apiURL == "" {
+		apiURL = "https://api.github.com"
+	}
+
+	reqURL := fmt.Sprintf("%s/app/installations/%s/access_tokens", apiURL, t.installationID)
+	httpReq, err := http.NewRequestWithContext(req.Context(), http.MethodPost, reqURL, nil)
+	if err != nil {
+		return "", err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+jwtToken)
+	httpReq.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := t.base.RoundTrip(httpReq)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("unexpected status minting installation token: %s", resp.Status)
+	}
+
+	var body struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", err
+	}
+
+	t.token = body.Token
+	t.expiresAt = body.ExpiresAt
+	if t.expiresAt.IsZero() {
+		t.expiresAt = time.Now().Add(installationTokenTTL)
+	}
+	return t.token, nil
+}
+
+func (t *appInstallationTransport) signAppJWT() (string, error) {
+	now := time.Now()
+	claims := jwt.RegisteredClaims{
+		IssuedAt:  jwt.NewNumericDate(now.Add(-30 * time.Second)),
+		ExpiresAt: jwt.NewNumericDate(now.Add(jwtValidity)),
+		Issuer:    t.appID,
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(t.key)
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func newTestAppTransport(t *testing.T, apiURL string) (*appInstallationTransport, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	return &appInstallationTransport{
+		appID:          "app-1",
+		installationID: "install-1",
+		key:            key,
+		base:           http.DefaultTransport,
+		apiURL:         apiURL,
+	}, key
+}
+
+func TestSignAppJWT(t *testing.T) {
+	at, key := newTestAppTransport(t, "")
+
+	tokenStr, err := at.signAppJWT()
+	if err != nil {
+		t.Fatalf("signAppJWT() error = %v", err)
+	}
+
+	parsed, err := jwt.ParseWithClaims(tokenStr, &jwt.RegisteredClaims{}, func(*jwt.Token) (any, error) {
+		return &key.PublicKey, nil
+	})
+	if err != nil {
+		t.Fatalf("ParseWithClaims() error = %v", err)
+	}
+	claims := parsed.Claims.(*jwt.RegisteredClaims)
+	if claims.Issuer != "app-1" {
+		t.Errorf("Issuer = %q, want %q", claims.Issuer, "app-1")
+	}
+}
+
+func TestInstallationTokenBuildsURLWithoutDoubleSlash(t *testing.T) {
+	var gotPath string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"token":      "installation-token",
+			"expires_at": time.Now().Add(time.Hour).Format(time.RFC3339),
+		})
+	}))
+	defer srv.Close()
+
+	// api.github.com's BaseURL, and any custom github_urls.api, come from
+	// go-github/tmpl.Apply with a trailing slash; apiURL must strip it
+	// before concatenating the installation-token path.
+	at, _ := newTestAppTransport(t, srv.URL+"/")
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.invalid", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+
+	token, err := at.installationToken(req)
+	if err != nil {
+		t.Fatalf("installationToken() error = %v", err)
+	}
+	if token != "installation-token" {
+		t.Errorf("token = %q, want %q", token, "installation-token")
+	}
+	if wantPath := "/app/installations/install-1/access_tokens"; gotPath != wantPath {
+		t.Errorf("path = %q, want %q", gotPath, wantPath)
+	}
+}
+
+func TestInstallationTokenCachesUntilExpirySkew(t *testing.T) {
+	var requests int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"token":      "installation-token",
+			"expires_at": time.Now().Add(time.Hour).Format(time.RFC3339),
+		})
+	}))
+	defer srv.Close()
+
+	at, _ := newTestAppTransport(t, srv.URL)
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.invalid", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+
+	if _, err := at.installationToken(req); err != nil {
+		t.Fatalf("installationToken() error = %v", err)
+	}
+	if _, err := at.installationToken(req); err != nil {
+		t.Fatalf("installationToken() error = %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("requests = %d, want 1 (second call should hit the cache)", requests)
+	}
+}
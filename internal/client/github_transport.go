@@ -0,0 +1,191 @@
+package client
+
+import (
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/caarlos0/log"
+)
+
+const (
+	defaultRetries   = 3
+	maxRetrySleep    = 60 * time.Second
+	minRateRemaining = 5
+)
+
+// retryTransport wraps a base http.RoundTripper and retries requests that
+// fail with a 5xx, a 429, or a secondary-rate-limited 403, honoring
+// GitHub's Retry-After/X-RateLimit-Reset headers. It also tracks the last
+// seen rate-limit remaining count so callers can throttle themselves.
+type retryTransport struct {
+	base    http.RoundTripper
+	retries int
+
+	remaining int64 // atomic
+}
+
+func newRetryTransport(base http.RoundTripper, retries int) *retryTransport {
+	if retries <= 0 {
+		retries = defaultRetries
+	}
+	return &retryTransport{base: base, retries: retries, remaining: -1}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; attempt <= t.retries; attempt++ {
+		resp, err = t.base.RoundTrip(cloneRequest(req))
+		if err == nil {
+			t.recordRateLimit(resp)
+			if !shouldRetry(resp) {
+				return resp, nil
+			}
+		}
+
+		if attempt == t.retries {
+			break
+		}
+
+		wait := retryDelay(resp, err, attempt)
+		log.WithFields(log.Fields{
+			"attempt":    attempt + 1,
+			"max":        t.retries,
+			"wait":       wait.String(),
+			"request-id": requestID(resp),
+		}).Warn("retrying GitHub request")
+
+		if resp != nil && resp.Body != nil {
+			io.Copy(io.Discard, resp.Body) //nolint:errcheck
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+
+	return resp, err
+}
+
+// RateRemaining returns the last observed value of X-RateLimit-Remaining.
+// It returns -1 if no response has been seen yet.
+func (t *retryTransport) RateRemaining() int64 {
+	return atomic.LoadInt64(&t.remaining)
+}
+
+func (t *retryTransport) recordRateLimit(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+	if v, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Remaining"), 10, 64); err == nil {
+		atomic.StoreInt64(&t.remaining, v)
+	}
+}
+
+func shouldRetry(resp *http.Response) bool {
+	if resp == nil {
+		return true
+	}
+	switch {
+	case resp.StatusCode >= 500:
+		return true
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0":
+		return true
+	default:
+		return false
+	}
+}
+
+func retryDelay(resp *http.Response, err error, attempt int) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfter(resp); ok {
+			return capDelay(d)
+		}
+		if d, ok := rateLimitReset(resp); ok {
+			return capDelay(d)
+		}
+	}
+	// exponential backoff with jitter for network errors / unlabelled 5xx.
+	base := time.Duration(1<<attempt) * time.Second
+	jitter := time.Duration(rand.Int63n(int64(base))) //nolint:gosec
+	return capDelay(base + jitter)
+}
+
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	return 0, false
+}
+
+func rateLimitReset(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("X-RateLimit-Reset")
+	if v == "" {
+		return 0, false
+	}
+	epoch, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	d := time.Until(time.Unix(epoch, 0))
+	if d < 0 {
+		d = 0
+	}
+	return d, true
+}
+
+func capDelay(d time.Duration) time.Duration {
+	if d > maxRetrySleep {
+		return maxRetrySleep
+	}
+	return d
+}
+
+func requestID(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+	return resp.Header.Get("X-GitHub-Request-Id")
+}
+
+func cloneRequest(req *http.Request) *http.Request {
+	clone := req.Clone(req.Context())
+	if req.Body == nil {
+		return clone
+	}
+
+	// http.NewRequest only populates GetBody for *bytes.Buffer/Reader and
+	// *strings.Reader, not for the *os.File bodies every asset upload
+	// uses, so fall back to rewinding the original body in place when
+	// it's seekable — otherwise a retry would resend whatever bytes are
+	// left after the previous, failed attempt instead of the full file.
+	// The rewound body is handed down without its Close, since the base
+	// transport closes the request body after each attempt and a closed
+	// file can't be rewound for the next one; the caller closes the file.
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			clone.Body = body
+			return clone
+		}
+	}
+	if seeker, ok := req.Body.(io.Seeker); ok {
+		if _, err := seeker.Seek(0, io.SeekStart); err == nil {
+			clone.Body = io.NopCloser(req.Body)
+		}
+	}
+	return clone
+}
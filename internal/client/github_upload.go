@@ -0,0 +1,83 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+
+	"github.com/google/go-github/v48/github"
+	"github.com/goreleaser/goreleaser/internal/artifact"
+	"github.com/goreleaser/goreleaser/pkg/context"
+)
+
+// DefaultParallelUploads returns the default number of artifacts to upload
+// concurrently when release.parallel_uploads isn't set.
+func DefaultParallelUploads() int {
+	if n := runtime.NumCPU(); n < 4 {
+		return n
+	}
+	return 4
+}
+
+// parallelUploads resolves how many artifacts to upload at once, honoring
+// release.parallel_uploads and falling back to DefaultParallelUploads.
+func parallelUploads(ctx *context.Context) int {
+	if n := ctx.Config.Release.ParallelUploads; n > 0 {
+		return n
+	}
+	return DefaultParallelUploads()
+}
+
+// UploadAll uploads every given artifact concurrently, bounded by
+// release.parallel_uploads (default min(4, NumCPU)). open is responsible
+// for producing the *os.File for a given artifact; UploadAll closes it
+// once the upload for that artifact is done. Each artifact's upload is
+// independent, so there's no shared state for concurrent goroutines to
+// clobber.
+func (c *githubClient) UploadAll(
+	ctx *context.Context,
+	releaseID string,
+	artifacts []*artifact.Artifact,
+	open func(*artifact.Artifact) (*os.File, error),
+) error {
+	sem := make(chan struct{}, parallelUploads(ctx))
+	errs := make([]error, len(artifacts))
+
+	var wg sync.WaitGroup
+	for i, a := range artifacts {
+		i, a := i, a
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			file, err := open(a)
+			if err != nil {
+				errs[i] = fmt.Errorf("opening %s: %w", a.Name, err)
+				return
+			}
+			defer file.Close()
+
+			errs[i] = c.Upload(ctx, releaseID, a, file)
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assetMatches reports whether an uploaded asset's metadata matches what we
+// expect, used to verify a completed upload before trusting it.
+func assetMatches(asset *github.ReleaseAsset, expectedSize int64) bool {
+	if asset == nil {
+		return false
+	}
+	return int64(asset.GetSize()) == expectedSize && asset.GetNodeID() != ""
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/google/go-github/v48/github"
+	"github.com/goreleaser/goreleaser/internal/artifact"
+	"github.com/goreleaser/goreleaser/pkg/config"
+	"github.com/goreleaser/goreleaser/pkg/context"
+)
+
+// TestUploadRetriesOnAbortedUpload simulates a 502 mid-upload followed by a
+// successful retry, and asserts the retried request carries the full asset
+// body rather than whatever was left after the aborted first attempt.
+func TestUploadRetriesOnAbortedUpload(t *testing.T) {
+	const content = "the-full-asset-bytes"
+
+	var attempts int
+	var gotBodies []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		gotBodies = append(gotBodies, string(body))
+
+		if attempts < 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(github.ReleaseAsset{
+			ID:     github.Int64(1),
+			NodeID: github.String("node1"),
+			Name:   github.String("widget.tar.gz"),
+			Size:   github.Int(len(content)),
+		})
+	}))
+	defer srv.Close()
+
+	uploadURL, err := url.Parse(srv.URL + "/")
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+
+	transport := newRetryTransport(http.DefaultTransport, 3)
+	ghClient := github.NewClient(&http.Client{Transport: transport})
+	ghClient.UploadURL = uploadURL
+
+	cli := &githubClient{client: ghClient, transport: transport}
+
+	tmp, err := os.CreateTemp(t.TempDir(), "widget")
+	if err != nil {
+		t.Fatalf("CreateTemp() error = %v", err)
+	}
+	defer tmp.Close()
+	if _, err := tmp.WriteString(content); err != nil {
+		t.Fatalf("WriteString() error = %v", err)
+	}
+	if _, err := tmp.Seek(0, 0); err != nil {
+		t.Fatalf("Seek() error = %v", err)
+	}
+
+	ctx := context.New(config.Project{
+		Release: config.Release{GitHub: config.GitHub{Owner: "acme", Name: "widgets"}},
+	})
+
+	if err := cli.Upload(ctx, "1", &artifact.Artifact{Name: "widget.tar.gz"}, tmp); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+	for i, body := range gotBodies {
+		if body != content {
+			t.Errorf("attempt %d body = %q, want %q", i+1, body, content)
+		}
+	}
+}
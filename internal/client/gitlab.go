@@ -0,0 +1,254 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/caarlos0/log"
+	"github.com/goreleaser/goreleaser/internal/artifact"
+	"github.com/goreleaser/goreleaser/internal/tmpl"
+	"github.com/goreleaser/goreleaser/pkg/config"
+	"github.com/goreleaser/goreleaser/pkg/context"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+type gitlabClient struct {
+	client *gitlab.Client
+}
+
+// NewGitLab returns a gitlab client implementation.
+func NewGitLab(ctx *context.Context, token string) (Client, error) {
+	client, err := gitlab.NewClient(token)
+	if err != nil {
+		return &gitlabClient{}, err
+	}
+	return &gitlabClient{client: client}, nil
+}
+
+func (c *gitlabClient) GenerateReleaseNotes(ctx *context.Context, repo Repo, prev, current string) (string, error) {
+	return "", nil
+}
+
+func (c *gitlabClient) Changelog(ctx *context.Context, repo Repo, prev, current string) (string, error) {
+	compare, _, err := c.client.Repositories.Compare(repo.String(), &gitlab.CompareOptions{
+		From: gitlab.String(prev),
+		To:   gitlab.String(current),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var entries []string
+	for _, commit := range compare.Commits {
+		entries = append(entries, fmt.Sprintf(
+			"%s: %s (@%s)",
+			commit.ShortID,
+			strings.Split(commit.Message, "\n")[0],
+			commit.AuthorName,
+		))
+	}
+	return strings.Join(entries, "\n"), nil
+}
+
+func (c *gitlabClient) GetDefaultBranch(ctx *context.Context, repo Repo) (string, error) {
+	p, _, err := c.client.Projects.GetProject(repo.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	return p.DefaultBranch, nil
+}
+
+// ListReleases returns the releases of the configured repo, newest first,
+// optionally including drafts and capped at opts.Limit (0 means no cap).
+// GitLab releases have no draft concept, so IncludeDrafts is a no-op.
+func (c *gitlabClient) ListReleases(ctx *context.Context, repo Repo, opts ListReleasesOptions) ([]Release, error) {
+	listOpts := &gitlab.ListReleasesOptions{PerPage: 100}
+
+	var releases []Release
+	for {
+		page, resp, err := c.client.Releases.ListReleases(repo.String(), listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page {
+			releases = append(releases, gitlabToRelease(r))
+			if opts.Limit > 0 && len(releases) >= opts.Limit {
+				return releases, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpts.Page = resp.NextPage
+	}
+
+	return releases, nil
+}
+
+// GetReleaseByTag returns a single release identified by its tag.
+func (c *gitlabClient) GetReleaseByTag(ctx *context.Context, repo Repo, tag string) (*Release, error) {
+	r, _, err := c.client.Releases.GetRelease(repo.String(), tag)
+	if err != nil {
+		return nil, err
+	}
+	release := gitlabToRelease(r)
+	return &release, nil
+}
+
+func gitlabToRelease(r *gitlab.Release) Release {
+	assets := make([]ReleaseAsset, 0, len(r.Assets.Links))
+	for _, l := range r.Assets.Links {
+		assets = append(assets, ReleaseAsset{Name: l.Name})
+	}
+	var publishedAt time.Time
+	if r.ReleasedAt != nil {
+		publishedAt = *r.ReleasedAt
+	}
+	return Release{
+		Tag:         r.TagName,
+		Name:        r.Name,
+		PublishedAt: publishedAt,
+		Assets:      assets,
+	}
+}
+
+// CloseMilestone closes a given milestone.
+func (c *gitlabClient) CloseMilestone(ctx *context.Context, repo Repo, title string) error {
+	milestone, err := c.getMilestoneByTitle(repo, title)
+	if err != nil {
+		return err
+	}
+	if milestone == nil {
+		return ErrNoMilestoneFound{Title: title}
+	}
+
+	closeStateEvent := "close"
+	_, _, err = c.client.Milestones.UpdateMilestone(repo.String(), milestone.ID, &gitlab.UpdateMilestoneOptions{
+		StateEvent: &closeStateEvent,
+	})
+	return err
+}
+
+func (c *gitlabClient) getMilestoneByTitle(repo Repo, title string) (*gitlab.Milestone, error) {
+	opts := &gitlab.ListMilestonesOptions{PerPage: 100}
+	for {
+		milestones, resp, err := c.client.Milestones.ListMilestones(repo.String(), opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range milestones {
+			if m.Title == title {
+				return m, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return nil, nil
+}
+
+func (c *gitlabClient) CreateFile(
+	ctx *context.Context,
+	commitAuthor config.CommitAuthor,
+	repo Repo,
+	content []byte,
+	path,
+	message string,
+) error {
+	branch := repo.Branch
+	if branch == "" {
+		b, err := c.GetDefaultBranch(ctx, repo)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"fileName":  path,
+				"projectID": repo.String(),
+				"err":       err.Error(),
+			}).Warn("error checking for default branch, using default")
+		}
+		branch = b
+	}
+
+	contentStr := string(content)
+	if _, _, err := c.client.RepositoryFiles.GetFile(repo.String(), path, &gitlab.GetFileOptions{Ref: &branch}); err != nil {
+		_, _, err := c.client.RepositoryFiles.CreateFile(repo.String(), path, &gitlab.CreateFileOptions{
+			Branch:        &branch,
+			Content:       &contentStr,
+			CommitMessage: &message,
+			AuthorName:    &commitAuthor.Name,
+			AuthorEmail:   &commitAuthor.Email,
+		})
+		return err
+	}
+
+	_, _, err := c.client.RepositoryFiles.UpdateFile(repo.String(), path, &gitlab.UpdateFileOptions{
+		Branch:        &branch,
+		Content:       &contentStr,
+		CommitMessage: &message,
+		AuthorName:    &commitAuthor.Name,
+		AuthorEmail:   &commitAuthor.Email,
+	})
+	return err
+}
+
+func (c *gitlabClient) CreateRelease(ctx *context.Context, body string) (string, error) {
+	title, err := tmpl.New(ctx).Apply(ctx.Config.Release.NameTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	repo := Repo{Owner: ctx.Config.Release.GitLab.Owner, Name: ctx.Config.Release.GitLab.Name}
+
+	if _, _, err := c.client.Releases.GetRelease(repo.String(), ctx.Git.CurrentTag); err != nil {
+		if _, _, err := c.client.Releases.CreateRelease(repo.String(), &gitlab.CreateReleaseOptions{
+			Name:        &title,
+			TagName:     &ctx.Git.CurrentTag,
+			Description: &body,
+		}); err != nil {
+			return "", fmt.Errorf("could not release: %w", err)
+		}
+		return ctx.Git.CurrentTag, nil
+	}
+
+	if _, _, err := c.client.Releases.UpdateRelease(repo.String(), ctx.Git.CurrentTag, &gitlab.UpdateReleaseOptions{
+		Name:        &title,
+		Description: &body,
+	}); err != nil {
+		return "", fmt.Errorf("could not release: %w", err)
+	}
+	return ctx.Git.CurrentTag, nil
+}
+
+func (c *gitlabClient) ReleaseURLTemplate(ctx *context.Context) (string, error) {
+	return fmt.Sprintf(
+		"%s/%s/%s/-/releases/{{ .Tag }}/downloads/{{ .ArtifactName }}",
+		"https://gitlab.com",
+		ctx.Config.Release.GitLab.Owner,
+		ctx.Config.Release.GitLab.Name,
+	), nil
+}
+
+func (c *gitlabClient) Upload(
+	ctx *context.Context,
+	releaseID string,
+	art *artifact.Artifact,
+	file *os.File,
+) error {
+	repo := Repo{Owner: ctx.Config.Release.GitLab.Owner, Name: ctx.Config.Release.GitLab.Name}
+
+	projectFile, _, err := c.client.Projects.UploadFile(repo.String(), file, art.Name)
+	if err != nil {
+		return RetriableError{err}
+	}
+
+	linkName := art.Name
+	_, _, err = c.client.ReleaseLinks.CreateReleaseLink(repo.String(), releaseID, &gitlab.CreateReleaseLinkOptions{
+		Name: &linkName,
+		URL:  &projectFile.URL,
+	})
+	return err
+}
+
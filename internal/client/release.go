@@ -0,0 +1,37 @@
+package client
+
+import "time"
+
+// Release is a forge-agnostic view of a single release, used by the
+// `release list`/`release show` commands so they can render GitHub,
+// GitLab and Gitea releases the same way.
+type Release struct {
+	ID          int64
+	Tag         string
+	Name        string
+	Draft       bool
+	Prerelease  bool
+	PublishedAt time.Time
+	Assets      []ReleaseAsset
+}
+
+// ReleaseAsset is a single uploaded file attached to a Release.
+type ReleaseAsset struct {
+	Name string
+	Size int64
+}
+
+// TotalAssetSize sums the size of every asset attached to the release.
+func (r Release) TotalAssetSize() int64 {
+	var total int64
+	for _, a := range r.Assets {
+		total += a.Size
+	}
+	return total
+}
+
+// ListReleasesOptions controls pagination/filtering for ListReleases.
+type ListReleasesOptions struct {
+	IncludeDrafts bool
+	Limit         int
+}
@@ -0,0 +1,157 @@
+package discord
+
+import (
+	"fmt"
+
+	"github.com/caarlos0/log"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/rest"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/goreleaser/goreleaser/internal/artifact"
+	"github.com/goreleaser/goreleaser/internal/tmpl"
+	"github.com/goreleaser/goreleaser/pkg/context"
+)
+
+// announceViaBot posts the release announcement through a bot token
+// instead of a webhook, attaching download/changelog/checksum buttons and
+// optionally creating a discussion thread for the release.
+func (p Pipe) announceViaBot(ctx *context.Context, botToken, msg string, embeds []discord.Embed) error {
+	cfg := ctx.Config.Announce.Discord
+
+	channelID, err := tmpl.New(ctx).Apply(cfg.ChannelID)
+	if err != nil {
+		return fmt.Errorf("discord: templating channel_id: %w", err)
+	}
+	if channelID == "" {
+		return fmt.Errorf("discord: channel_id is required when bot_token is set")
+	}
+	channel, err := snowflake.Parse(channelID)
+	if err != nil {
+		return fmt.Errorf("discord: %w", err)
+	}
+
+	client := rest.NewClient(botToken)
+
+	target := channel
+	if cfg.CreateThread {
+		threadName := msg
+		if cfg.ThreadNameTemplate != "" {
+			threadName, err = tmpl.New(ctx).Apply(cfg.ThreadNameTemplate)
+			if err != nil {
+				return fmt.Errorf("discord: templating thread_name: %w", err)
+			}
+		}
+		thread, err := client.CreateThread(channel, discord.GuildPublicThreadCreate{
+			Name: threadName,
+		})
+		if err != nil {
+			return fmt.Errorf("discord: creating thread: %w", err)
+		}
+		target = thread.ID()
+		log.Infof("created thread %q for the release", threadName)
+	}
+
+	components := actionRows(buttons(ctx))
+
+	for _, chunk := range chunkEmbeds(embeds) {
+		if _, err := client.CreateMessage(target, discord.MessageCreate{
+			Content:    msg,
+			Embeds:     chunk,
+			Components: components,
+		}); err != nil {
+			return fmt.Errorf("discord: %w", err)
+		}
+		// only attach the components to the first message; continuation
+		// embeds just carry the rest of the changelog/artifact list.
+		components = nil
+	}
+
+	return nil
+}
+
+const (
+	// maxButtonsPerRow is Discord's cap on components in a single action row.
+	maxButtonsPerRow = 5
+	// maxActionRows is Discord's cap on action rows in a single message.
+	maxActionRows = 5
+)
+
+// actionRows packs buttons into action rows of at most maxButtonsPerRow,
+// capped at maxActionRows rows (Discord's limits), dropping any buttons
+// beyond that so the message stays valid instead of being rejected outright.
+func actionRows(buttons []discord.InteractiveComponent) []discord.InteractionComponent {
+	var rows []discord.InteractionComponent
+	for len(buttons) > 0 && len(rows) < maxActionRows {
+		n := maxButtonsPerRow
+		if n > len(buttons) {
+			n = len(buttons)
+		}
+		rows = append(rows, discord.NewActionRow(buttons[:n]...))
+		buttons = buttons[n:]
+	}
+	if len(buttons) > 0 {
+		log.Warnf("dropping %d button(s): message already has the maximum %d action rows", len(buttons), maxActionRows)
+	}
+	return rows
+}
+
+// buttons builds the "Download" (one per major OS/arch group), "Changelog"
+// and "Verify checksums" link buttons shown under the release message.
+func buttons(ctx *context.Context) []discord.InteractiveComponent {
+	var buttons []discord.InteractiveComponent
+
+	base := releaseDownloadBase(ctx)
+	for _, group := range artifactGroups(ctx) {
+		buttons = append(buttons, discord.NewLinkButton(
+			fmt.Sprintf("Download (%s)", group.label),
+			fmt.Sprintf("%s/%s", base, group.artifactName),
+		))
+	}
+
+	buttons = append(buttons, discord.NewLinkButton("Changelog", ctx.ReleaseURL))
+
+	if sumURL := checksumsURL(ctx); sumURL != "" {
+		buttons = append(buttons, discord.NewLinkButton("Verify checksums", sumURL))
+	}
+
+	return buttons
+}
+
+// artifactGroup pairs an "os_arch" label with the name of one representative
+// artifact from that group, so a download button can link straight at a
+// real file instead of the group label itself.
+type artifactGroup struct {
+	label        string
+	artifactName string
+}
+
+// artifactGroups returns the distinct "os_arch" groups among publishable
+// artifacts, so we can offer one download button per major platform
+// instead of one per individual file.
+func artifactGroups(ctx *context.Context) []artifactGroup {
+	seen := map[string]bool{}
+	var groups []artifactGroup
+	for _, a := range ctx.Artifacts.Filter(artifact.Or(
+		artifact.ByType(artifact.UploadableArchive),
+		artifact.ByType(artifact.UploadableBinary),
+	)).List() {
+		label := a.Goos
+		if a.Goarch != "" {
+			label = fmt.Sprintf("%s_%s", a.Goos, a.Goarch)
+		}
+		if label == "" || seen[label] {
+			continue
+		}
+		seen[label] = true
+		groups = append(groups, artifactGroup{label: label, artifactName: a.Name})
+	}
+	return groups
+}
+
+func checksumsURL(ctx *context.Context) string {
+	sums := ctx.Artifacts.Filter(artifact.ByType(artifact.Checksum)).List()
+	if len(sums) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s", releaseDownloadBase(ctx), sums[0].Name)
+}
@@ -3,12 +3,14 @@ package discord
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/caarlos0/env/v6"
 	"github.com/caarlos0/log"
 	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/webhook"
 	"github.com/disgoorg/snowflake/v2"
+	"github.com/goreleaser/goreleaser/internal/artifact"
 	"github.com/goreleaser/goreleaser/internal/tmpl"
 	"github.com/goreleaser/goreleaser/pkg/context"
 )
@@ -18,6 +20,22 @@ const (
 	defaultColor           = "3888754"
 	defaultIcon            = "https://goreleaser.com/static/avatar.png"
 	defaultMessageTemplate = `{{ .ProjectName }} {{ .Tag }} is out! Check it out at {{ .ReleaseURL }}`
+
+	// embedLimit is the hard cap Discord enforces on the combined size of a
+	// single embed (title + description + fields + footer + author).
+	embedLimit = 6000
+
+	// defaultMaxChangelogLines caps how much of the changelog we fold into
+	// the embed when the user doesn't set MaxChangelogLines.
+	defaultMaxChangelogLines = 15
+
+	// embedFieldValueLimit is Discord's hard cap on a single embed field's
+	// value, separate from (and tighter than) the embedLimit we split on.
+	embedFieldValueLimit = 1024
+
+	// changelogFence is the length of the ```\n...\n``` wrapper the
+	// changelog field's value is rendered in.
+	changelogFence = 8
 )
 
 type Pipe struct{}
@@ -26,8 +44,9 @@ func (Pipe) String() string                 { return "discord" }
 func (Pipe) Skip(ctx *context.Context) bool { return !ctx.Config.Announce.Discord.Enabled }
 
 type Config struct {
-	WebhookID    string `env:"DISCORD_WEBHOOK_ID,notEmpty"`
-	WebhookToken string `env:"DISCORD_WEBHOOK_TOKEN,notEmpty"`
+	WebhookID    string `env:"DISCORD_WEBHOOK_ID"`
+	WebhookToken string `env:"DISCORD_WEBHOOK_TOKEN"`
+	BotToken     string `env:"DISCORD_BOT_TOKEN"`
 }
 
 func (p Pipe) Default(ctx *context.Context) error {
@@ -43,44 +62,280 @@ func (p Pipe) Default(ctx *context.Context) error {
 	if ctx.Config.Announce.Discord.Color == "" {
 		ctx.Config.Announce.Discord.Color = defaultColor
 	}
+	if ctx.Config.Announce.Discord.MaxChangelogLines == 0 {
+		ctx.Config.Announce.Discord.MaxChangelogLines = defaultMaxChangelogLines
+	}
 	return nil
 }
 
 func (p Pipe) Announce(ctx *context.Context) error {
-	msg, err := tmpl.New(ctx).Apply(ctx.Config.Announce.Discord.MessageTemplate)
+	cfg := ctx.Config.Announce.Discord
+
+	msg, err := tmpl.New(ctx).Apply(cfg.MessageTemplate)
 	if err != nil {
 		return fmt.Errorf("discord: %w", err)
 	}
 
-	var cfg Config
-	if err = env.Parse(&cfg); err != nil {
+	var envCfg Config
+	if err = env.Parse(&envCfg); err != nil {
 		return fmt.Errorf("discord: %w", err)
 	}
 
-	log.Infof("posting: '%s'", msg)
-
-	webhookID, err := snowflake.Parse(cfg.WebhookID)
+	color, err := strconv.Atoi(cfg.Color)
 	if err != nil {
 		return fmt.Errorf("discord: %w", err)
 	}
 
-	color, err := strconv.Atoi(ctx.Config.Announce.Discord.Color)
+	embeds, err := p.buildEmbeds(ctx, msg, color)
 	if err != nil {
 		return fmt.Errorf("discord: %w", err)
 	}
-	if _, err = webhook.New(webhookID, cfg.WebhookToken).CreateMessage(discord.WebhookMessageCreate{
-		Embeds: []discord.Embed{
-			{
-				Author: &discord.EmbedAuthor{
-					Name:    ctx.Config.Announce.Discord.Author,
-					IconURL: ctx.Config.Announce.Discord.IconURL,
-				},
-				Description: msg,
-				Color:       color,
-			},
-		},
-	}); err != nil {
+
+	if envCfg.BotToken != "" {
+		return p.announceViaBot(ctx, envCfg.BotToken, msg, embeds)
+	}
+	return p.announceViaWebhook(envCfg, embeds)
+}
+
+func (p Pipe) announceViaWebhook(envCfg Config, embeds []discord.Embed) error {
+	if envCfg.WebhookID == "" || envCfg.WebhookToken == "" {
+		return fmt.Errorf("discord: missing DISCORD_WEBHOOK_ID/DISCORD_WEBHOOK_TOKEN")
+	}
+
+	webhookID, err := snowflake.Parse(envCfg.WebhookID)
+	if err != nil {
 		return fmt.Errorf("discord: %w", err)
 	}
+
+	client := webhook.New(webhookID, envCfg.WebhookToken)
+	for _, chunk := range chunkEmbeds(embeds) {
+		log.Infof("posting %d embed(s)", len(chunk))
+		if _, err := client.CreateMessage(discord.WebhookMessageCreate{
+			Embeds: chunk,
+		}); err != nil {
+			return fmt.Errorf("discord: %w", err)
+		}
+	}
 	return nil
 }
+
+// buildEmbeds assembles the full-fidelity release embed: author/title/url,
+// the release description, an optional changelog field, and an optional
+// grouped list of artifact download links with their checksums.
+func (p Pipe) buildEmbeds(ctx *context.Context, msg string, color int) ([]discord.Embed, error) {
+	cfg := ctx.Config.Announce.Discord
+
+	title := msg
+	if cfg.TitleTemplate != "" {
+		t, err := tmpl.New(ctx).Apply(cfg.TitleTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("templating title: %w", err)
+		}
+		title = t
+	} else if cfg.Title != "" {
+		t, err := tmpl.New(ctx).Apply(cfg.Title)
+		if err != nil {
+			return nil, fmt.Errorf("templating title: %w", err)
+		}
+		title = t
+	}
+
+	url := ctx.ReleaseURL
+	if cfg.URL != "" {
+		u, err := tmpl.New(ctx).Apply(cfg.URL)
+		if err != nil {
+			return nil, fmt.Errorf("templating url: %w", err)
+		}
+		url = u
+	}
+
+	embed := discord.Embed{
+		Author: &discord.EmbedAuthor{
+			Name:    cfg.Author,
+			IconURL: cfg.IconURL,
+		},
+		Title:       title,
+		Description: msg,
+		URL:         url,
+		Color:       color,
+	}
+
+	if cfg.Thumbnail != "" {
+		thumb, err := tmpl.New(ctx).Apply(cfg.Thumbnail)
+		if err != nil {
+			return nil, fmt.Errorf("templating thumbnail: %w", err)
+		}
+		embed.Thumbnail = &discord.EmbedResource{URL: thumb}
+	}
+
+	if cfg.Footer != "" {
+		footer, err := tmpl.New(ctx).Apply(cfg.Footer)
+		if err != nil {
+			return nil, fmt.Errorf("templating footer: %w", err)
+		}
+		embed.Footer = &discord.EmbedFooter{Text: footer}
+	}
+
+	for _, f := range cfg.Fields {
+		name, err := tmpl.New(ctx).Apply(f.Name)
+		if err != nil {
+			return nil, fmt.Errorf("templating field name: %w", err)
+		}
+		value, err := tmpl.New(ctx).Apply(f.Value)
+		if err != nil {
+			return nil, fmt.Errorf("templating field value: %w", err)
+		}
+		inline := f.Inline
+		embed.Fields = append(embed.Fields, discord.EmbedField{
+			Name:   name,
+			Value:  value,
+			Inline: &inline,
+		})
+	}
+
+	if cfg.IncludeChangelog && ctx.ReleaseNotes != "" {
+		embed.Fields = append(embed.Fields, discord.EmbedField{
+			Name:  "Changelog",
+			Value: fmt.Sprintf("```\n%s\n```", truncateChangelog(ctx.ReleaseNotes, cfg.MaxChangelogLines)),
+		})
+	}
+
+	if cfg.IncludeArtifacts {
+		if field := artifactsField(ctx); field.Value != "" {
+			embed.Fields = append(embed.Fields, field)
+		}
+	}
+
+	return splitEmbed(embed), nil
+}
+
+// artifactsField builds a single embed field grouping publishable artifact
+// download links together with their checksums, when available.
+func artifactsField(ctx *context.Context) discord.EmbedField {
+	checksums := map[string]string{}
+	for _, a := range ctx.Artifacts.Filter(artifact.ByType(artifact.Checksum)).List() {
+		for name, sum := range artifact.ExtraOr(*a, "Checksums", map[string]string{}) {
+			checksums[name] = sum
+		}
+	}
+
+	var lines []string
+	for _, a := range ctx.Artifacts.Filter(artifact.Or(
+		artifact.ByType(artifact.UploadableArchive),
+		artifact.ByType(artifact.UploadableBinary),
+		artifact.ByType(artifact.LinuxPackage),
+	)).List() {
+		line := fmt.Sprintf("[%s](%s/%s)", a.Name, releaseDownloadBase(ctx), a.Name)
+		if sum, ok := checksums[a.Name]; ok {
+			line = fmt.Sprintf("%s — `%s`", line, sum)
+		}
+		lines = append(lines, line)
+	}
+
+	return discord.EmbedField{
+		Name:  "Artifacts",
+		Value: strings.Join(lines, "\n"),
+	}
+}
+
+// releaseDownloadBase returns the base URL artifacts are downloaded from,
+// i.e. everything up to (and including) the tag in
+// ".../releases/download/{{ .Tag }}/{{ .ArtifactName }}" — ctx.ReleaseURL
+// itself points at the release page, not at downloadable files.
+func releaseDownloadBase(ctx *context.Context) string {
+	switch {
+	case ctx.Config.Release.GitHub.Name != "":
+		base := ctx.Config.GitHubURLs.Download
+		if base == "" {
+			base = "https://github.com"
+		}
+		return fmt.Sprintf("%s/%s/%s/releases/download/%s", base, ctx.Config.Release.GitHub.Owner, ctx.Config.Release.GitHub.Name, ctx.Git.CurrentTag)
+	case ctx.Config.Release.GitLab.Name != "":
+		return fmt.Sprintf("https://gitlab.com/%s/%s/-/releases/%s/downloads", ctx.Config.Release.GitLab.Owner, ctx.Config.Release.GitLab.Name, ctx.Git.CurrentTag)
+	case ctx.Config.Release.Gitea.Name != "":
+		return fmt.Sprintf("%s/%s/%s/releases/download/%s", ctx.Config.GiteaURLs.Download, ctx.Config.Release.Gitea.Owner, ctx.Config.Release.Gitea.Name, ctx.Git.CurrentTag)
+	default:
+		return ctx.ReleaseURL
+	}
+}
+
+// truncateChangelog caps the changelog both by line count (MaxChangelogLines)
+// and, since a default-configured changelog routinely exceeds Discord's
+// 1024-char embed field value limit well under that many lines, by
+// character count too.
+func truncateChangelog(notes string, maxLines int) string {
+	lines := strings.Split(strings.TrimSpace(notes), "\n")
+	droppedLines := 0
+	if len(lines) > maxLines {
+		droppedLines = len(lines) - maxLines
+		lines = lines[:maxLines]
+	}
+
+	text := strings.Join(lines, "\n")
+	if droppedLines > 0 {
+		text += fmt.Sprintf("\n… and %d more", droppedLines)
+	}
+
+	if limit := embedFieldValueLimit - changelogFence; len(text) > limit {
+		text = text[:limit-1] + "…"
+	}
+	return text
+}
+
+// splitEmbed breaks an embed into multiple embeds so none of them exceeds
+// Discord's 6000 character combined-content limit. The author/title/url
+// carry over to the continuation embeds so they still read well on their
+// own.
+func splitEmbed(embed discord.Embed) []discord.Embed {
+	if embedSize(embed) <= embedLimit {
+		return []discord.Embed{embed}
+	}
+
+	var embeds []discord.Embed
+	current := embed
+	current.Fields = nil
+
+	for _, f := range embed.Fields {
+		candidate := current
+		candidate.Fields = append(append([]discord.EmbedField{}, current.Fields...), f)
+		if embedSize(candidate) > embedLimit && len(current.Fields) > 0 {
+			embeds = append(embeds, current)
+			current = discord.Embed{Author: embed.Author, Title: embed.Title, URL: embed.URL, Color: embed.Color}
+			candidate = current
+			candidate.Fields = []discord.EmbedField{f}
+		}
+		current = candidate
+	}
+	embeds = append(embeds, current)
+	return embeds
+}
+
+func embedSize(embed discord.Embed) int {
+	size := len(embed.Title) + len(embed.Description)
+	if embed.Footer != nil {
+		size += len(embed.Footer.Text)
+	}
+	if embed.Author != nil {
+		size += len(embed.Author.Name)
+	}
+	for _, f := range embed.Fields {
+		size += len(f.Name) + len(f.Value)
+	}
+	return size
+}
+
+// chunkEmbeds groups embeds into batches of at most 10, Discord's limit on
+// embeds per message.
+func chunkEmbeds(embeds []discord.Embed) [][]discord.Embed {
+	const maxPerMessage = 10
+	var chunks [][]discord.Embed
+	for len(embeds) > 0 {
+		n := maxPerMessage
+		if n > len(embeds) {
+			n = len(embeds)
+		}
+		chunks = append(chunks, embeds[:n])
+		embeds = embeds[n:]
+	}
+	return chunks
+}
@@ -0,0 +1,116 @@
+package config
+
+// CommitAuthor is the author used in generated commits (e.g. homebrew tap
+// bumps) when the repo's own committer identity isn't used.
+type CommitAuthor struct {
+	Name  string `yaml:"name,omitempty" json:"name,omitempty"`
+	Email string `yaml:"email,omitempty" json:"email,omitempty"`
+}
+
+// GitHubURLs allows overriding the GitHub URLs, for use with GitHub
+// Enterprise or self-hosted instances.
+type GitHubURLs struct {
+	API           string `yaml:"api,omitempty" json:"api,omitempty"`
+	Upload        string `yaml:"upload,omitempty" json:"upload,omitempty"`
+	Download      string `yaml:"download,omitempty" json:"download,omitempty"`
+	SkipTLSVerify bool   `yaml:"skip_tls_verify,omitempty" json:"skip_tls_verify,omitempty"`
+
+	// Retries bounds how many times a failed GitHub request (5xx, 429, or
+	// a secondary-rate-limited 403) is retried before giving up.
+	Retries int `yaml:"retries,omitempty" json:"retries,omitempty"`
+
+	// App selects GitHub App installation auth over a PAT when the
+	// GITHUB_APP_* env vars are set.
+	App bool `yaml:"app,omitempty" json:"app,omitempty"`
+}
+
+// GiteaURLs allows overriding the Gitea URLs, mirroring GitHubURLs for
+// self-hosted Gitea instances.
+type GiteaURLs struct {
+	API           string `yaml:"api,omitempty" json:"api,omitempty"`
+	Download      string `yaml:"download,omitempty" json:"download,omitempty"`
+	SkipTLSVerify bool   `yaml:"skip_tls_verify,omitempty" json:"skip_tls_verify,omitempty"`
+}
+
+// GitHub points goreleaser to the GitHub repo to release to.
+type GitHub struct {
+	Owner string `yaml:"owner,omitempty" json:"owner,omitempty"`
+	Name  string `yaml:"name,omitempty" json:"name,omitempty"`
+}
+
+// GitLab points goreleaser to the GitLab repo to release to.
+type GitLab struct {
+	Owner string `yaml:"owner,omitempty" json:"owner,omitempty"`
+	Name  string `yaml:"name,omitempty" json:"name,omitempty"`
+}
+
+// Gitea points goreleaser to the Gitea repo to release to.
+type Gitea struct {
+	Owner string `yaml:"owner,omitempty" json:"owner,omitempty"`
+	Name  string `yaml:"name,omitempty" json:"name,omitempty"`
+}
+
+// Release config used for the GitHub/GitLab/Gitea releases.
+type Release struct {
+	GitHub GitHub `yaml:"github,omitempty" json:"github,omitempty"`
+	GitLab GitLab `yaml:"gitlab,omitempty" json:"gitlab,omitempty"`
+	Gitea  Gitea  `yaml:"gitea,omitempty" json:"gitea,omitempty"`
+
+	Draft                   bool   `yaml:"draft,omitempty" json:"draft,omitempty"`
+	ReplaceExistingDraft    bool   `yaml:"replace_existing_draft,omitempty" json:"replace_existing_draft,omitempty"`
+	DiscussionCategoryName  string `yaml:"discussion_category_name,omitempty" json:"discussion_category_name,omitempty"`
+	TargetCommitish         string `yaml:"target_commitish,omitempty" json:"target_commitish,omitempty"`
+	NameTemplate            string `yaml:"name_template,omitempty" json:"name_template,omitempty"`
+	ReleaseNotesMode        string `yaml:"mode,omitempty" json:"mode,omitempty"`
+
+	// ParallelUploads bounds how many release assets are uploaded at the
+	// same time. Defaults to min(4, NumCPU) when unset.
+	ParallelUploads int `yaml:"parallel_uploads,omitempty" json:"parallel_uploads,omitempty"`
+}
+
+// DiscordField is a single templated embed field.
+type DiscordField struct {
+	Name   string `yaml:"name,omitempty" json:"name,omitempty"`
+	Value  string `yaml:"value,omitempty" json:"value,omitempty"`
+	Inline bool   `yaml:"inline,omitempty" json:"inline,omitempty"`
+}
+
+// Discord announce config.
+type Discord struct {
+	Enabled         bool   `yaml:"enabled,omitempty" json:"enabled,omitempty"`
+	MessageTemplate string `yaml:"message_template,omitempty" json:"message_template,omitempty"`
+	IconURL         string `yaml:"icon_url,omitempty" json:"icon_url,omitempty"`
+	Author          string `yaml:"author,omitempty" json:"author,omitempty"`
+	Color           string `yaml:"color,omitempty" json:"color,omitempty"`
+
+	Title             string         `yaml:"title,omitempty" json:"title,omitempty"`
+	TitleTemplate     string         `yaml:"title_template,omitempty" json:"title_template,omitempty"`
+	URL               string         `yaml:"url,omitempty" json:"url,omitempty"`
+	Thumbnail         string         `yaml:"thumbnail,omitempty" json:"thumbnail,omitempty"`
+	Footer            string         `yaml:"footer,omitempty" json:"footer,omitempty"`
+	Fields            []DiscordField `yaml:"fields,omitempty" json:"fields,omitempty"`
+	IncludeChangelog  bool           `yaml:"include_changelog,omitempty" json:"include_changelog,omitempty"`
+	IncludeArtifacts  bool           `yaml:"include_artifacts,omitempty" json:"include_artifacts,omitempty"`
+	MaxChangelogLines int            `yaml:"max_changelog_lines,omitempty" json:"max_changelog_lines,omitempty"`
+
+	// ChannelID, CreateThread and ThreadNameTemplate only apply to the
+	// bot-token path (DISCORD_BOT_TOKEN); the webhook path ignores them.
+	ChannelID          string `yaml:"channel_id,omitempty" json:"channel_id,omitempty"`
+	CreateThread       bool   `yaml:"create_thread,omitempty" json:"create_thread,omitempty"`
+	ThreadNameTemplate string `yaml:"thread_name_template,omitempty" json:"thread_name_template,omitempty"`
+}
+
+// Announce config, one block per supported service.
+type Announce struct {
+	Discord Discord `yaml:"discord,omitempty" json:"discord,omitempty"`
+}
+
+// Project is the root goreleaser configuration.
+type Project struct {
+	Dist string `yaml:"dist,omitempty" json:"dist,omitempty"`
+
+	Release    Release    `yaml:"release,omitempty" json:"release,omitempty"`
+	Announce   Announce   `yaml:"announce,omitempty" json:"announce,omitempty"`
+	GitHubURLs GitHubURLs `yaml:"github_urls,omitempty" json:"github_urls,omitempty"`
+	GiteaURLs  GiteaURLs  `yaml:"gitea_urls,omitempty" json:"gitea_urls,omitempty"`
+}
@@ -0,0 +1,24 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Load reads and parses the goreleaser config file at path.
+func Load(path string) (Project, error) {
+	var config Project
+	f, err := os.Open(path)
+	if err != nil {
+		return config, fmt.Errorf("opening config file: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	if err := dec.Decode(&config); err != nil {
+		return config, fmt.Errorf("parsing config file: %w", err)
+	}
+	return config, nil
+}